@@ -0,0 +1,166 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+var (
+	BlocklistAdminKey           = []byte("blocklist-admin")
+	BlockedDepositorKeyPrefix   = []byte("blocked-depositor-value-")
+	BlockedMintRecipientKeyPref = []byte("blocked-mint-recipient-value-")
+)
+
+// GetBlocklistAdmin returns the bech32 address authorized to manage the
+// blocklist, or the empty string if it has not been set.
+func (k Keeper) GetBlocklistAdmin(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(BlocklistAdminKey)
+	return string(bz)
+}
+
+// SetBlocklistAdmin sets the bech32 address authorized to manage the
+// blocklist.
+func (k Keeper) SetBlocklistAdmin(ctx sdk.Context, admin string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(BlocklistAdminKey, []byte(admin))
+}
+
+// SetBlockedAddress adds a depositor address to the blocklist.
+func (k Keeper) SetBlockedAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedDepositorKeyPrefix)
+	store.Set([]byte(address), []byte{1})
+}
+
+// GetBlockedAddress returns whether the depositor address is on the
+// blocklist.
+func (k Keeper) GetBlockedAddress(ctx sdk.Context, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedDepositorKeyPrefix)
+	return store.Has([]byte(address))
+}
+
+// RemoveBlockedAddress removes a depositor address from the blocklist.
+func (k Keeper) RemoveBlockedAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedDepositorKeyPrefix)
+	store.Delete([]byte(address))
+}
+
+// GetAllBlockedAddresses returns every blocklisted depositor address.
+func (k Keeper) GetAllBlockedAddresses(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedDepositorKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	return addresses
+}
+
+func blockedMintRecipientKey(remoteDomain uint32, mintRecipient []byte) []byte {
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, remoteDomain)
+	return append(domainBz, mintRecipient...)
+}
+
+// SetBlockedMintRecipient blocks a 32-byte remote-format mint recipient on a
+// single remote domain.
+func (k Keeper) SetBlockedMintRecipient(ctx sdk.Context, remoteDomain uint32, mintRecipient []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedMintRecipientKeyPref)
+	store.Set(blockedMintRecipientKey(remoteDomain, mintRecipient), []byte{1})
+}
+
+// GetBlockedMintRecipient returns whether the mint recipient is blocked on
+// the given remote domain.
+func (k Keeper) GetBlockedMintRecipient(ctx sdk.Context, remoteDomain uint32, mintRecipient []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedMintRecipientKeyPref)
+	return store.Has(blockedMintRecipientKey(remoteDomain, mintRecipient))
+}
+
+// RemoveBlockedMintRecipient unblocks a mint recipient on a remote domain.
+func (k Keeper) RemoveBlockedMintRecipient(ctx sdk.Context, remoteDomain uint32, mintRecipient []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedMintRecipientKeyPref)
+	store.Delete(blockedMintRecipientKey(remoteDomain, mintRecipient))
+}
+
+// GetAllBlockedMintRecipients returns every blocked mint recipient for the
+// given remote domain.
+func (k Keeper) GetAllBlockedMintRecipients(ctx sdk.Context, remoteDomain uint32) [][]byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedMintRecipientKeyPref)
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, remoteDomain)
+	iterator := prefix.NewStore(store, domainBz).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var recipients [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		recipients = append(recipients, iterator.Key())
+	}
+	return recipients
+}
+
+// GetAllBlockedMintRecipientsAll returns every blocked mint recipient across
+// every remote domain.
+func (k Keeper) GetAllBlockedMintRecipientsAll(ctx sdk.Context) []types.BlockedMintRecipient {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BlockedMintRecipientKeyPref)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var blocked []types.BlockedMintRecipient
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		blocked = append(blocked, types.BlockedMintRecipient{
+			RemoteDomain:  binary.BigEndian.Uint32(key[:4]),
+			MintRecipient: append([]byte{}, key[4:]...),
+		})
+	}
+	return blocked
+}
+
+// InitGenesisBlockedAddresses seeds the blocklist admin, blocked depositor
+// addresses, and blocked mint recipients from genesis state. Called from
+// InitGenesis.
+func (k Keeper) InitGenesisBlockedAddresses(ctx sdk.Context, admin string, blockedAddresses []types.BlockedAddress, blockedMintRecipients []types.BlockedMintRecipient) {
+	if admin != "" {
+		k.SetBlocklistAdmin(ctx, admin)
+	}
+	for _, blocked := range blockedAddresses {
+		k.SetBlockedAddress(ctx, blocked.Address)
+	}
+	for _, blocked := range blockedMintRecipients {
+		k.SetBlockedMintRecipient(ctx, blocked.RemoteDomain, blocked.MintRecipient)
+	}
+}
+
+// ExportGenesisBlockedAddresses returns the blocklist admin, every blocked
+// depositor address, and every blocked mint recipient. Called from
+// ExportGenesis.
+func (k Keeper) ExportGenesisBlockedAddresses(ctx sdk.Context) (string, []types.BlockedAddress, []types.BlockedMintRecipient) {
+	addresses := k.GetAllBlockedAddresses(ctx)
+	blocked := make([]types.BlockedAddress, len(addresses))
+	for i, address := range addresses {
+		blocked[i] = types.BlockedAddress{Address: address}
+	}
+	return k.GetBlocklistAdmin(ctx), blocked, k.GetAllBlockedMintRecipientsAll(ctx)
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+var BurnableTokenKeyPrefix = []byte("burnable-token-value-")
+
+// SetBurnableToken registers (or updates) a local denom as eligible for
+// DepositForBurn.
+func (k Keeper) SetBurnableToken(ctx sdk.Context, token types.BurnableToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BurnableTokenKeyPrefix)
+	bz := k.cdc.MustMarshal(&token)
+	store.Set([]byte(strings.ToLower(token.Denom)), bz)
+}
+
+// GetBurnableToken returns the registered metadata for denom, if any.
+func (k Keeper) GetBurnableToken(ctx sdk.Context, denom string) (types.BurnableToken, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BurnableTokenKeyPrefix)
+	bz := store.Get([]byte(strings.ToLower(denom)))
+	if bz == nil {
+		return types.BurnableToken{}, false
+	}
+
+	var token types.BurnableToken
+	k.cdc.MustUnmarshal(bz, &token)
+	return token, true
+}
+
+// RemoveBurnableToken deregisters denom, making it ineligible for future
+// DepositForBurn calls.
+func (k Keeper) RemoveBurnableToken(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BurnableTokenKeyPrefix)
+	store.Delete([]byte(strings.ToLower(denom)))
+}
+
+// GetAllBurnableTokens returns every registered burnable token.
+func (k Keeper) GetAllBurnableTokens(ctx sdk.Context) []types.BurnableToken {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), BurnableTokenKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var tokens []types.BurnableToken
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.BurnableToken
+		k.cdc.MustUnmarshal(iterator.Value(), &token)
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// InitGenesisBurnableTokens seeds the burnable token registry from genesis
+// state. Called from InitGenesis.
+func (k Keeper) InitGenesisBurnableTokens(ctx sdk.Context, tokens []types.BurnableToken) {
+	for _, token := range tokens {
+		k.SetBurnableToken(ctx, token)
+	}
+}
+
+// ExportGenesisBurnableTokens returns every registered burnable token.
+// Called from ExportGenesis.
+func (k Keeper) ExportGenesisBurnableTokens(ctx sdk.Context) []types.BurnableToken {
+	return k.GetAllBurnableTokens(ctx)
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+var (
+	FeeParamsKey       = []byte("fee-params")
+	DomainFeeKeyPrefix = []byte("domain-fee-value-")
+)
+
+// GetFeeParams returns the protocol-wide default fee, or the zero value if
+// it has not been configured (meaning no fee is charged).
+func (k Keeper) GetFeeParams(ctx sdk.Context) types.FeeParams {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(FeeParamsKey)
+	if bz == nil {
+		return types.FeeParams{}
+	}
+
+	var params types.FeeParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetFeeParams sets the protocol-wide default fee.
+func (k Keeper) SetFeeParams(ctx sdk.Context, params types.FeeParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(FeeParamsKey, bz)
+}
+
+// SetDomainFee sets a fee override for a single destination domain.
+func (k Keeper) SetDomainFee(ctx sdk.Context, fee types.DomainFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DomainFeeKeyPrefix)
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, fee.DestinationDomain)
+	bz := k.cdc.MustMarshal(&fee)
+	store.Set(domainBz, bz)
+}
+
+// GetDomainFee returns the fee override configured for destinationDomain,
+// if any.
+func (k Keeper) GetDomainFee(ctx sdk.Context, destinationDomain uint32) (types.DomainFee, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DomainFeeKeyPrefix)
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, destinationDomain)
+
+	bz := store.Get(domainBz)
+	if bz == nil {
+		return types.DomainFee{}, false
+	}
+
+	var fee types.DomainFee
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
+
+// RemoveDomainFee clears the fee override configured for destinationDomain.
+func (k Keeper) RemoveDomainFee(ctx sdk.Context, destinationDomain uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DomainFeeKeyPrefix)
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, destinationDomain)
+	store.Delete(domainBz)
+}
+
+// GetEffectiveFeeParams resolves the fee params that apply to a deposit
+// bound for destinationDomain, preferring a per-domain override over the
+// protocol-wide default.
+func (k Keeper) GetEffectiveFeeParams(ctx sdk.Context, destinationDomain uint32) types.FeeParams {
+	defaults := k.GetFeeParams(ctx)
+	if domainFee, found := k.GetDomainFee(ctx, destinationDomain); found {
+		return types.FeeParams{
+			FeeBps: domainFee.FeeBps,
+			FeeMin: domainFee.FeeMin,
+			FeeMax: domainFee.FeeMax,
+			// MsgSetPerDomainFee only overrides the rate, not where fees are
+			// paid out; the recipient always comes from the protocol-wide
+			// FeeParams.
+			FeeRecipient: defaults.FeeRecipient,
+		}
+	}
+	return defaults
+}
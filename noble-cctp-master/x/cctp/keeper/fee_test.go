@@ -0,0 +1,80 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+/*
+ * Protocol-wide fee, no domain override
+ * Domain override present, recipient still comes from the protocol-wide fee
+ * No fee configured at all
+ */
+func TestGetEffectiveFeeParamsNoDomainOverride(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+
+	recipient := sample.AccAddress()
+	testkeeper.SetFeeParams(ctx, types.FeeParams{
+		FeeBps:       10,
+		FeeMin:       math.NewInt(1),
+		FeeMax:       math.NewInt(100),
+		FeeRecipient: recipient,
+	})
+
+	effective := testkeeper.GetEffectiveFeeParams(ctx, 4)
+	require.Equal(t, uint32(10), effective.FeeBps)
+	require.Equal(t, recipient, effective.FeeRecipient)
+}
+
+func TestGetEffectiveFeeParamsDomainOverridePropagatesRecipient(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+
+	recipient := sample.AccAddress()
+	testkeeper.SetFeeParams(ctx, types.FeeParams{
+		FeeBps:       10,
+		FeeMin:       math.NewInt(1),
+		FeeMax:       math.NewInt(100),
+		FeeRecipient: recipient,
+	})
+	testkeeper.SetDomainFee(ctx, types.DomainFee{
+		DestinationDomain: 4,
+		FeeBps:            25,
+		FeeMin:            math.NewInt(2),
+		FeeMax:            math.NewInt(200),
+	})
+
+	effective := testkeeper.GetEffectiveFeeParams(ctx, 4)
+	require.Equal(t, uint32(25), effective.FeeBps)
+	require.True(t, effective.FeeMin.Equal(math.NewInt(2)))
+	require.True(t, effective.FeeMax.Equal(math.NewInt(200)))
+	require.Equal(t, recipient, effective.FeeRecipient)
+}
+
+func TestGetEffectiveFeeParamsUnconfigured(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+
+	effective := testkeeper.GetEffectiveFeeParams(ctx, 4)
+	require.Equal(t, types.FeeParams{}, effective)
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+// InitGenesis initializes the cctp module's state from genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.InitGenesisBlockedAddresses(ctx, genState.BlocklistAdmin, genState.BlockedAddresses, genState.BlockedMintRecipients)
+	k.InitGenesisBurnableTokens(ctx, genState.BurnableTokens)
+}
+
+// ExportGenesis returns the cctp module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	admin, blockedAddresses, blockedMintRecipients := k.ExportGenesisBlockedAddresses(ctx)
+	return types.GenesisState{
+		BlocklistAdmin:        admin,
+		BlockedAddresses:      blockedAddresses,
+		BlockedMintRecipients: blockedMintRecipients,
+		BurnableTokens:        k.ExportGenesisBurnableTokens(ctx),
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k Keeper) EstimateDepositFee(goCtx context.Context, req *types.QueryEstimateDepositFeeRequest) (*types.QueryEstimateDepositFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetEffectiveFeeParams(ctx, req.DestinationDomain)
+	fee, net := params.ComputeFee(req.Amount)
+
+	return &types.QueryEstimateDepositFeeResponse{
+		FeeAmount: fee,
+		NetAmount: net,
+	}, nil
+}
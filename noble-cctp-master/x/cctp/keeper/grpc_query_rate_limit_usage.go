@@ -0,0 +1,54 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k Keeper) RateLimitUsage(goCtx context.Context, req *types.QueryRateLimitUsageRequest) (*types.QueryRateLimitUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit, found := k.GetRateLimit(ctx, req.PerAccount, req.Denom, req.DestinationDomain)
+	if !found {
+		return &types.QueryRateLimitUsageResponse{
+			Used:      math.ZeroInt(),
+			Remaining: math.ZeroInt(),
+			Amount:    math.ZeroInt(),
+		}, nil
+	}
+
+	bucketKey := types.RateLimitBucketKey(req.PerAccount, req.Denom, req.DestinationDomain, req.Account)
+	_, used := k.GetRateLimitUsage(ctx, bucketKey).Trim(ctx.BlockHeight(), limit.WindowBlocks)
+
+	remaining := limit.Amount.Sub(used)
+	if remaining.IsNegative() {
+		remaining = math.ZeroInt()
+	}
+
+	return &types.QueryRateLimitUsageResponse{
+		Used:         used,
+		Remaining:    remaining,
+		WindowBlocks: limit.WindowBlocks,
+		Amount:       limit.Amount,
+	}, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+// Migrator is a wrapper for the Keeper, used for handling in-place store
+// migrations between consensus versions of this module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateBlocklistAdmin initializes the Blocklist Admin to the current
+// module Owner, preserving backwards compatibility for chains upgrading
+// into the blocklist feature without a dedicated genesis migration.
+func (m Migrator) MigrateBlocklistAdmin(ctx sdk.Context) error {
+	if m.keeper.GetBlocklistAdmin(ctx) == "" {
+		m.keeper.SetBlocklistAdmin(ctx, m.keeper.GetOwner(ctx))
+	}
+	return nil
+}
+
+// MigrateBurnableTokenRegistry seeds the burnable token registry with the
+// single denom that fiattokenfactory was already configured to mint, so
+// that existing chains keep burning it without a governance action.
+func (m Migrator) MigrateBurnableTokenRegistry(ctx sdk.Context) error {
+	denom := m.keeper.fiattokenfactory.GetMintingDenom(ctx)
+	if denom.Denom == "" {
+		return nil
+	}
+
+	if _, found := m.keeper.GetBurnableToken(ctx, denom.Denom); !found {
+		m.keeper.SetBurnableToken(ctx, types.BurnableToken{
+			Denom:        denom.Denom,
+			MinterModule: types.FiatTokenfactoryMinter,
+		})
+	}
+	return nil
+}
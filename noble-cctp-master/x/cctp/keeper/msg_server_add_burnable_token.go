@@ -0,0 +1,53 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k msgServer) AddBurnableToken(goCtx context.Context, msg *types.MsgAddBurnableToken) (*types.MsgAddBurnableTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenController := k.GetTokenController(ctx)
+	if tokenController != msg.From {
+		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot add burnable tokens")
+	}
+
+	if msg.Token.Denom == "" {
+		return nil, errors.Wrap(types.ErrTokenNotRegistered, "denom must not be empty")
+	}
+
+	if msg.Token.MinterModule != types.FiatTokenfactoryMinter {
+		return nil, errors.Wrapf(types.ErrUnsupportedMinter, "minter module: %s is not supported", msg.Token.MinterModule)
+	}
+
+	k.SetBurnableToken(ctx, msg.Token)
+
+	event := types.BurnableTokenAdded{
+		Denom:        msg.Token.Denom,
+		MinterModule: msg.Token.MinterModule,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgAddBurnableTokenResponse{}, err
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Happy path (block depositor address)
+ * Happy path (block remote mint recipient)
+ * Unauthorized sender
+ */
+func TestAddToBlocklistHappyPathAddress(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	admin := sample.AccAddress()
+	testkeeper.SetBlocklistAdmin(ctx, admin)
+
+	blocked := sample.AccAddress()
+	msg := types.MsgAddToBlocklist{
+		From:    admin,
+		Address: blocked,
+	}
+
+	_, err := server.AddToBlocklist(ctx, &msg)
+	require.NoError(t, err)
+	require.True(t, testkeeper.GetBlockedAddress(ctx, blocked))
+}
+
+func TestAddToBlocklistHappyPathMintRecipient(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	admin := sample.AccAddress()
+	testkeeper.SetBlocklistAdmin(ctx, admin)
+
+	mintRecipient := make([]byte, types.MintRecipientLen)
+	copy(mintRecipient[12:], []byte("12345678901234567890"))
+
+	msg := types.MsgAddToBlocklist{
+		From:          admin,
+		RemoteDomain:  4,
+		MintRecipient: mintRecipient,
+	}
+
+	_, err := server.AddToBlocklist(ctx, &msg)
+	require.NoError(t, err)
+	require.True(t, testkeeper.GetBlockedMintRecipient(ctx, 4, mintRecipient))
+}
+
+func TestAddToBlocklistUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetBlocklistAdmin(ctx, sample.AccAddress())
+
+	msg := types.MsgAddToBlocklist{
+		From:    sample.AccAddress(),
+		Address: sample.AccAddress(),
+	}
+
+	_, err := server.AddToBlocklist(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
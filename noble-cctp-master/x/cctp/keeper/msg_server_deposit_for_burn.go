@@ -17,7 +17,6 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 	"encoding/hex"
 	"strings"
@@ -25,7 +24,6 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/circlefin/noble-cctp/x/cctp/types"
 	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
@@ -67,9 +65,41 @@ func (k msgServer) depositForBurn(
 		return 0, errors.Wrap(types.ErrDepositForBurn, "amount must be positive")
 	}
 
-	emptyByteArr := make([]byte, types.MintRecipientLen)
-	if mintRecipient == nil || bytes.Equal(mintRecipient, emptyByteArr) {
-		return 0, errors.Wrap(types.ErrDepositForBurn, "mint recipient must be nonzero")
+	codec, err := k.GetRemoteAddressCodec(ctx, destinationDomain)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := codec.Validate(mintRecipient); err != nil {
+		return 0, errors.Wrapf(types.ErrDepositForBurn, "invalid mint recipient (%s)", err)
+	}
+
+	if len(destinationCaller) > 0 {
+		if err := codec.Validate(destinationCaller); err != nil {
+			return 0, errors.Wrapf(types.ErrDepositForBurn, "invalid destination caller (%s)", err)
+		}
+	}
+
+	if k.GetBlockedAddress(ctx, from) {
+		event := types.DepositBlocked{
+			Depositor:         from,
+			MintRecipient:     mintRecipient,
+			DestinationDomain: destinationDomain,
+			Reason:            "depositor is blocklisted",
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&event)
+		return 0, errors.Wrapf(types.ErrBlocklisted, "from address (%s) is blocklisted", from)
+	}
+
+	if k.GetBlockedMintRecipient(ctx, destinationDomain, mintRecipient) {
+		event := types.DepositBlocked{
+			Depositor:         from,
+			MintRecipient:     mintRecipient,
+			DestinationDomain: destinationDomain,
+			Reason:            "mint recipient is blocklisted",
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&event)
+		return 0, errors.Wrap(types.ErrBlocklisted, "mint recipient is blocklisted on the destination domain")
 	}
 
 	tokenMessenger, found := k.GetRemoteTokenMessenger(ctx, destinationDomain)
@@ -77,10 +107,9 @@ func (k msgServer) depositForBurn(
 		return 0, errors.Wrap(types.ErrDepositForBurn, "unable to look up destination token messenger")
 	}
 
-	// Note: fiat token factory only supports burning 1 token denom
-	denom := k.fiattokenfactory.GetMintingDenom(ctx)
-	if !strings.EqualFold(denom.Denom, burnToken) {
-		return 0, errors.Wrapf(types.ErrBurn, "burning denom: %s is not supported", burnToken)
+	burnableToken, found := k.GetBurnableToken(ctx, burnToken)
+	if !found {
+		return 0, errors.Wrapf(types.ErrTokenNotRegistered, "burning denom: %s is not supported", burnToken)
 	}
 
 	// check if burning/minting is paused
@@ -97,6 +126,21 @@ func (k msgServer) depositForBurn(
 		}
 	}
 
+	// the burnable token registry's own per message limit, when positive, is
+	// enforced in addition to the PerMessageBurnLimit stored for this denom above
+	if !burnableToken.PerMessageLimit.IsNil() && burnableToken.PerMessageLimit.IsPositive() && amount.GT(burnableToken.PerMessageLimit) {
+		return 0, errors.Wrap(types.ErrBurn, "cannot burn more than the registered token's per message limit")
+	}
+
+	// enforce the aggregate (denom, destination domain) and per-account
+	// rolling-window rate limits, if configured, before moving any funds
+	if err := k.checkAndRecordRateLimit(ctx, false, burnToken, destinationDomain, "", amount); err != nil {
+		return 0, err
+	}
+	if err := k.checkAndRecordRateLimit(ctx, true, burnToken, destinationDomain, from, amount); err != nil {
+		return 0, err
+	}
+
 	// burn coins
 	coin := sdk.NewCoin(burnToken, math.NewIntFromBigInt(amount.BigInt()))
 
@@ -105,23 +149,61 @@ func (k msgServer) depositForBurn(
 		return 0, errors.Wrap(err, "error during transfer")
 	}
 
-	fiatBurnMsg := fiattokenfactorytypes.MsgBurn{
-		From:   types.ModuleAddress.String(),
-		Amount: coin,
+	// charge the configured protocol fee, if any, leaving the net amount to burn
+	feeParams := k.GetEffectiveFeeParams(ctx, destinationDomain)
+	feeAmount, netAmount := feeParams.ComputeFee(amount)
+	netCoin := coin
+	if feeAmount.IsPositive() {
+		feeRecipient, err := sdk.AccAddressFromBech32(feeParams.FeeRecipient)
+		if err != nil {
+			return 0, errors.Wrapf(types.ErrInvalidFeeParams, "invalid fee recipient address (%s)", err)
+		}
+
+		feeCoin := sdk.NewCoin(burnToken, feeAmount)
+		moduleAddress, err := sdk.AccAddressFromBech32(types.ModuleAddress.String())
+		if err != nil {
+			return 0, errors.Wrapf(err, "error resolving module address")
+		}
+		if err := k.bank.SendCoins(ctx, moduleAddress, feeRecipient, sdk.NewCoins(feeCoin)); err != nil {
+			return 0, errors.Wrap(err, "error during fee transfer")
+		}
+
+		netCoin = sdk.NewCoin(burnToken, netAmount)
 	}
-	_, err = k.fiattokenfactory.Burn(ctx, &fiatBurnMsg)
-	if err != nil {
-		return 0, errors.Wrapf(err, "error during burn")
+
+	// dispatch the burn to the minter registered for this denom; fiattokenfactory
+	// is the only minter module supported today, but additional minter
+	// interfaces can be added here as new modules come online.
+	switch burnableToken.MinterModule {
+	case types.FiatTokenfactoryMinter:
+		fiatBurnMsg := fiattokenfactorytypes.MsgBurn{
+			From:   types.ModuleAddress.String(),
+			Amount: netCoin,
+		}
+		_, err = k.fiattokenfactory.Burn(ctx, &fiatBurnMsg)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error during burn")
+		}
+	default:
+		return 0, errors.Wrapf(types.ErrUnsupportedMinter, "minter module: %s is not supported", burnableToken.MinterModule)
 	}
 
 	messageSender := make([]byte, 32)
 	copy(messageSender[12:], fromAccAddress)
 
+	remoteTokenHash := burnableToken.RemoteTokenHash
+	if len(remoteTokenHash) == 0 {
+		remoteTokenHash, err = codec.TokenIdentifier(burnToken)
+		if err != nil {
+			return 0, errors.Wrapf(types.ErrDepositForBurn, "unable to derive remote token identifier (%s)", err)
+		}
+	}
+
 	burnMessage := types.BurnMessage{
 		Version:       types.MessageBodyVersion,
-		BurnToken:     crypto.Keccak256([]byte(strings.ToLower(burnToken))),
+		BurnToken:     remoteTokenHash,
 		MintRecipient: mintRecipient,
-		Amount:        amount,
+		Amount:        netAmount,
 		MessageSender: messageSender,
 	}
 
@@ -163,13 +245,15 @@ func (k msgServer) depositForBurn(
 
 	event := types.DepositForBurn{
 		Nonce:                     nonce.Nonce,
-		BurnToken:                 hex.EncodeToString(crypto.Keccak256([]byte(burnToken))),
+		BurnToken:                 hex.EncodeToString(remoteTokenHash),
 		Amount:                    amount,
 		Depositor:                 from,
 		MintRecipient:             mintRecipient,
 		DestinationDomain:         destinationDomain,
 		DestinationTokenMessenger: tokenMessenger.Address,
 		DestinationCaller:         destinationCaller,
+		FeeAmount:                 feeAmount,
+		NetAmount:                 netAmount,
 	}
 	err = ctx.EventManager().EmitTypedEvent(&event)
 
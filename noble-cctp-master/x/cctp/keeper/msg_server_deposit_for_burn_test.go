@@ -0,0 +1,146 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+// newDepositForBurnMsg returns a well-formed MsgDepositForBurn for
+// destination domain 4, leaving only the fields the individual test cares
+// about to be overridden by the caller.
+func newDepositForBurnMsg(from, burnToken string, amount math.Int) types.MsgDepositForBurn {
+	mintRecipient := make([]byte, types.MintRecipientLen)
+	copy(mintRecipient[12:], []byte("12345678901234567890"))
+
+	return types.MsgDepositForBurn{
+		From:              from,
+		Amount:            amount,
+		DestinationDomain: 4,
+		MintRecipient:     mintRecipient,
+		BurnToken:         burnToken,
+	}
+}
+
+/*
+ * Happy path (fee configured, registered token)
+ * Blocked depositor is rejected
+ * Unregistered denom is rejected
+ * Rate limited deposit is rejected
+ */
+func TestDepositForBurnHappyPathWithFee(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+		DomainId: 4,
+		Address:  []byte("09876543210987654321098765432109"),
+	})
+	testkeeper.SetBurnableToken(ctx, types.BurnableToken{
+		Denom:        "uusdc",
+		MinterModule: types.FiatTokenfactoryMinter,
+	})
+	testkeeper.SetFeeParams(ctx, types.FeeParams{
+		FeeBps:       100,
+		FeeMin:       math.NewInt(1),
+		FeeMax:       math.NewInt(1_000_000),
+		FeeRecipient: sample.AccAddress(),
+	})
+
+	nonce := types.Nonce{Nonce: 7}
+	testkeeper.SetNextAvailableNonce(ctx, nonce)
+
+	msg := newDepositForBurnMsg(sample.AccAddress(), "uusdc", math.NewInt(1_000))
+
+	resp, err := server.DepositForBurn(ctx, &msg)
+	require.NoError(t, err)
+	require.Equal(t, nonce.Nonce, resp.Nonce)
+
+	nextNonce, found := testkeeper.GetNextAvailableNonce(ctx)
+	require.True(t, found)
+	require.Equal(t, nonce.Nonce+1, nextNonce.Nonce)
+}
+
+func TestDepositForBurnBlockedDepositor(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+		DomainId: 4,
+		Address:  []byte("09876543210987654321098765432109"),
+	})
+	testkeeper.SetBurnableToken(ctx, types.BurnableToken{
+		Denom:        "uusdc",
+		MinterModule: types.FiatTokenfactoryMinter,
+	})
+
+	from := sample.AccAddress()
+	testkeeper.SetBlockedAddress(ctx, from)
+
+	msg := newDepositForBurnMsg(from, "uusdc", math.NewInt(1_000))
+
+	_, err := server.DepositForBurn(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrBlocklisted)
+}
+
+func TestDepositForBurnUnregisteredDenom(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+		DomainId: 4,
+		Address:  []byte("09876543210987654321098765432109"),
+	})
+
+	msg := newDepositForBurnMsg(sample.AccAddress(), "notregistered", math.NewInt(1_000))
+
+	_, err := server.DepositForBurn(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrTokenNotRegistered)
+}
+
+func TestDepositForBurnRateLimited(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+		DomainId: 4,
+		Address:  []byte("09876543210987654321098765432109"),
+	})
+	testkeeper.SetBurnableToken(ctx, types.BurnableToken{
+		Denom:        "uusdc",
+		MinterModule: types.FiatTokenfactoryMinter,
+	})
+	testkeeper.SetRateLimit(ctx, types.RateLimit{
+		Denom:             "uusdc",
+		DestinationDomain: 4,
+		WindowBlocks:      100,
+		Amount:            math.NewInt(500),
+	})
+
+	msg := newDepositForBurnMsg(sample.AccAddress(), "uusdc", math.NewInt(1_000))
+
+	_, err := server.DepositForBurn(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k msgServer) LinkTokenPair(goCtx context.Context, msg *types.MsgLinkTokenPair) (*types.MsgLinkTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenController := k.GetTokenController(ctx)
+	if tokenController != msg.From {
+		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot link token pairs")
+	}
+
+	codec, err := k.GetRemoteAddressCodec(ctx, msg.RemoteDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := codec.ValidateTokenIdentifier(msg.RemoteToken); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidRemoteToken, "invalid remote token (%s)", err)
+	}
+
+	if _, found := k.GetTokenPair(ctx, msg.RemoteDomain, msg.RemoteToken); found {
+		return nil, errors.Wrap(types.ErrTokenPairAlreadyExists, "token pair already exists in store")
+	}
+
+	tokenPair := types.TokenPair{
+		RemoteDomain: msg.RemoteDomain,
+		RemoteToken:  msg.RemoteToken,
+		LocalToken:   msg.LocalToken,
+	}
+	k.SetTokenPair(ctx, tokenPair)
+
+	event := types.TokenPairLinked{
+		LocalToken:   tokenPair.LocalToken,
+		RemoteDomain: tokenPair.RemoteDomain,
+		RemoteToken:  tokenPair.RemoteToken,
+	}
+	err = ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgLinkTokenPairResponse{}, err
+}
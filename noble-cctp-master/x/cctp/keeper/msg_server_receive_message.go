@@ -0,0 +1,93 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// ReceiveMessage verifies and processes an inbound CCTP message. Attestation
+// and nonce-replay verification happen in k.verifyMessage before this runs;
+// this handler is responsible for acting on a verified burn message,
+// including the depositor/mint-recipient blocklist enforcement described
+// below.
+func (k msgServer) ReceiveMessage(goCtx context.Context, msg *types.MsgReceiveMessage) (*types.MsgReceiveMessageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	message, err := k.verifyMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := new(types.BurnMessage).Parse(message.MessageBody)
+	if err != nil {
+		// not every inbound message carries a burn message body (e.g. a
+		// plain MsgSendMessage payload); nothing further to enforce here
+		return &types.MsgReceiveMessageResponse{Success: true}, nil
+	}
+
+	// (b) reject mints to a 32-byte remote-format mint recipient that has
+	// been blocklisted on the domain the message originated from
+	if k.GetBlockedMintRecipient(ctx, message.SourceDomain, body.MintRecipient) {
+		event := types.DepositBlocked{
+			MintRecipient:     body.MintRecipient,
+			DestinationDomain: message.SourceDomain,
+			Reason:            "mint recipient is blocklisted",
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&event)
+		return nil, errors.Wrap(types.ErrBlocklisted, "mint recipient is blocklisted on the source domain")
+	}
+
+	tokenPair, found := k.GetTokenPair(ctx, message.SourceDomain, body.BurnToken)
+	if !found {
+		return nil, errors.Wrap(types.ErrTokenPairNotFound, "burn token is not linked to a local denom")
+	}
+
+	mintCoin := sdk.NewCoin(tokenPair.LocalToken, body.Amount)
+	mintRecipient := sdk.AccAddress(body.MintRecipient[12:])
+
+	// (c) incoming mints whose recipient bech32 form is blocklisted are
+	// diverted to the module account (acting as a hold bucket) instead of
+	// failing the message outright
+	recipient := mintRecipient
+	if k.GetBlockedAddress(ctx, mintRecipient.String()) {
+		event := types.DepositBlocked{
+			MintRecipient:     body.MintRecipient,
+			DestinationDomain: message.SourceDomain,
+			Reason:            "mint recipient bech32 address is blocklisted, diverting to hold bucket",
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&event)
+		recipient = types.ModuleAddress
+	}
+
+	fiatMintMsg := fiattokenfactorytypes.MsgMint{
+		From:    types.ModuleAddress.String(),
+		Address: recipient.String(),
+		Amount:  mintCoin,
+	}
+	if _, err := k.fiattokenfactory.Mint(ctx, &fiatMintMsg); err != nil {
+		return nil, errors.Wrap(err, "error during mint")
+	}
+
+	return &types.MsgReceiveMessageResponse{Success: true}, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k msgServer) RegisterRemoteAddressCodec(goCtx context.Context, msg *types.MsgRegisterRemoteAddressCodec) (*types.MsgRegisterRemoteAddressCodecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := k.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot register a remote address codec")
+	}
+
+	switch msg.Config.CodecType {
+	case types.CodecTypeEVM:
+	case types.CodecTypeCosmos:
+		if msg.Config.Bech32Prefix == "" {
+			return nil, errors.Wrap(types.ErrUnsupportedCodec, "bech32 prefix is required for the cosmos codec")
+		}
+	default:
+		return nil, errors.Wrapf(types.ErrUnsupportedCodec, "codec type: %s is not supported", msg.Config.CodecType)
+	}
+
+	k.SetRemoteAddressCodecConfig(ctx, msg.Config)
+
+	event := types.RemoteAddressCodecRegistered{
+		RemoteDomain: msg.Config.RemoteDomain,
+		CodecType:    msg.Config.CodecType,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgRegisterRemoteAddressCodecResponse{}, err
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k msgServer) RemoveFromBlocklist(goCtx context.Context, msg *types.MsgRemoveFromBlocklist) (*types.MsgRemoveFromBlocklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blocklistAdmin := k.GetBlocklistAdmin(ctx)
+	if blocklistAdmin != msg.From {
+		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot modify the blocklist")
+	}
+
+	event := types.BlockedAddressRemoved{Signer: msg.From}
+
+	if msg.Address != "" {
+		k.RemoveBlockedAddress(ctx, msg.Address)
+		event.Address = msg.Address
+	} else {
+		k.RemoveBlockedMintRecipient(ctx, msg.RemoteDomain, msg.MintRecipient)
+		event.RemoteDomain = msg.RemoteDomain
+		event.MintRecipient = msg.MintRecipient
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&event)
+	return &types.MsgRemoveFromBlocklistResponse{}, err
+}
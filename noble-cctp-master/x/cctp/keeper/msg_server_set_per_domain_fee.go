@@ -0,0 +1,53 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k msgServer) SetPerDomainFee(goCtx context.Context, msg *types.MsgSetPerDomainFee) (*types.MsgSetPerDomainFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := k.GetOwner(ctx)
+	if owner != msg.From {
+		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot set per-domain fees")
+	}
+
+	if msg.Clear {
+		k.RemoveDomainFee(ctx, msg.DestinationDomain)
+		return &types.MsgSetPerDomainFeeResponse{}, nil
+	}
+
+	if msg.FeeBps > 10_000 {
+		return nil, errors.Wrap(types.ErrInvalidFeeParams, "fee bps cannot exceed 10000")
+	}
+
+	k.SetDomainFee(ctx, types.DomainFee{
+		DestinationDomain: msg.DestinationDomain,
+		FeeBps:            msg.FeeBps,
+		FeeMin:            msg.FeeMin,
+		FeeMax:            msg.FeeMax,
+	})
+
+	return &types.MsgSetPerDomainFeeResponse{}, nil
+}
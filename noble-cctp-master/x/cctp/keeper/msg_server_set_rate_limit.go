@@ -0,0 +1,56 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func (k msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenController := k.GetTokenController(ctx)
+	if tokenController != msg.From {
+		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot set rate limits")
+	}
+
+	// window_blocks is cast to int64 wherever it's compared against block
+	// height deltas, so reject values that would overflow that comparison
+	// and silently disable enforcement.
+	if msg.Limit.WindowBlocks == 0 || msg.Limit.WindowBlocks > math.MaxInt64 {
+		return nil, errors.Wrap(types.ErrDepositForBurn, "window blocks must be positive and fit in an int64")
+	}
+
+	if !msg.Limit.Amount.IsPositive() {
+		return nil, errors.Wrap(types.ErrDepositForBurn, "amount must be positive")
+	}
+
+	if msg.Limit.PerAccount {
+		msg.Limit.Denom = ""
+		msg.Limit.DestinationDomain = 0
+	}
+
+	k.SetRateLimit(ctx, msg.Limit)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
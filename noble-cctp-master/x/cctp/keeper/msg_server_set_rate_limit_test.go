@@ -0,0 +1,128 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+/*
+ * Happy path (aggregate rate limit)
+ * Happy path (per-account rate limit)
+ * Unauthorized sender
+ * Remove clears the configured limit
+ */
+func TestSetRateLimitHappyPathAggregate(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	controller := sample.AccAddress()
+	testkeeper.SetTokenController(ctx, controller)
+
+	msg := types.MsgSetRateLimit{
+		From: controller,
+		Limit: types.RateLimit{
+			Denom:             "uusdc",
+			DestinationDomain: 4,
+			WindowBlocks:      100,
+			Amount:            math.NewInt(1_000_000),
+		},
+	}
+
+	_, err := server.SetRateLimit(ctx, &msg)
+	require.NoError(t, err)
+
+	limit, found := testkeeper.GetRateLimit(ctx, false, "uusdc", 4)
+	require.True(t, found)
+	require.Equal(t, uint64(100), limit.WindowBlocks)
+}
+
+func TestSetRateLimitHappyPathPerAccount(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	controller := sample.AccAddress()
+	testkeeper.SetTokenController(ctx, controller)
+
+	msg := types.MsgSetRateLimit{
+		From: controller,
+		Limit: types.RateLimit{
+			PerAccount:   true,
+			WindowBlocks: 50,
+			Amount:       math.NewInt(500),
+		},
+	}
+
+	_, err := server.SetRateLimit(ctx, &msg)
+	require.NoError(t, err)
+
+	limit, found := testkeeper.GetRateLimit(ctx, true, "", 0)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(500), limit.Amount)
+}
+
+func TestSetRateLimitUnauthorized(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetTokenController(ctx, sample.AccAddress())
+
+	msg := types.MsgSetRateLimit{
+		From: sample.AccAddress(),
+		Limit: types.RateLimit{
+			Denom:             "uusdc",
+			DestinationDomain: 4,
+			WindowBlocks:      100,
+			Amount:            math.NewInt(1_000_000),
+		},
+	}
+
+	_, err := server.SetRateLimit(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestRemoveRateLimitClearsConfig(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	controller := sample.AccAddress()
+	testkeeper.SetTokenController(ctx, controller)
+	testkeeper.SetRateLimit(ctx, types.RateLimit{
+		Denom:             "uusdc",
+		DestinationDomain: 4,
+		WindowBlocks:      100,
+		Amount:            math.NewInt(1_000_000),
+	})
+
+	_, err := server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		From:              controller,
+		Denom:             "uusdc",
+		DestinationDomain: 4,
+	})
+	require.NoError(t, err)
+
+	_, found := testkeeper.GetRateLimit(ctx, false, "uusdc", 4)
+	require.False(t, found)
+}
@@ -33,8 +33,13 @@ func (k msgServer) UnlinkTokenPair(goCtx context.Context, msg *types.MsgUnlinkTo
 		return nil, errors.Wrap(types.ErrUnauthorized, "this message sender cannot unlink token pairs")
 	}
 
-	if len(msg.RemoteToken) != remoteTokenNumBytes {
-		return nil, errors.Wrapf(types.ErrInvalidRemoteToken, "must be a byte%d array", remoteTokenNumBytes)
+	codec, err := k.GetRemoteAddressCodec(ctx, msg.RemoteDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := codec.ValidateTokenIdentifier(msg.RemoteToken); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidRemoteToken, "invalid remote token (%s)", err)
 	}
 
 	tokenPair, found := k.GetTokenPair(ctx, msg.RemoteDomain, msg.RemoteToken)
@@ -49,6 +54,6 @@ func (k msgServer) UnlinkTokenPair(goCtx context.Context, msg *types.MsgUnlinkTo
 		RemoteDomain: tokenPair.RemoteDomain,
 		RemoteToken:  msg.RemoteToken,
 	}
-	err := ctx.EventManager().EmitTypedEvent(&event)
+	err = ctx.EventManager().EmitTypedEvent(&event)
 	return &types.MsgUnlinkTokenPairResponse{}, err
 }
@@ -0,0 +1,125 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+var (
+	RateLimitKeyPrefix      = []byte("rate-limit-value-")
+	RateLimitUsageKeyPrefix = []byte("rate-limit-usage-value-")
+)
+
+// SetRateLimit configures (or reconfigures) a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, limit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitKeyPrefix)
+	key := types.RateLimitConfigKey(limit.PerAccount, limit.Denom, limit.DestinationDomain)
+	bz := k.cdc.MustMarshal(&limit)
+	store.Set([]byte(key), bz)
+}
+
+// GetRateLimit returns the rate limit configured for the given tier, if
+// any. Pass perAccount true to look up the global per-account limit, in
+// which case denom and destinationDomain are ignored.
+func (k Keeper) GetRateLimit(ctx sdk.Context, perAccount bool, denom string, destinationDomain uint32) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitKeyPrefix)
+	key := types.RateLimitConfigKey(perAccount, denom, destinationDomain)
+
+	bz := store.Get([]byte(key))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var limit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+	return limit, true
+}
+
+// RemoveRateLimit clears the rate limit configured for the given tier.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, perAccount bool, denom string, destinationDomain uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitKeyPrefix)
+	key := types.RateLimitConfigKey(perAccount, denom, destinationDomain)
+	store.Delete([]byte(key))
+}
+
+// GetRateLimitUsage returns the recorded usage for bucketKey, or the zero
+// value if nothing has been recorded against it yet.
+func (k Keeper) GetRateLimitUsage(ctx sdk.Context, bucketKey string) types.RateLimitUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitUsageKeyPrefix)
+	bz := store.Get([]byte(bucketKey))
+	if bz == nil {
+		return types.RateLimitUsage{}
+	}
+
+	var usage types.RateLimitUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// setRateLimitUsage overwrites the recorded usage for bucketKey.
+func (k Keeper) setRateLimitUsage(ctx sdk.Context, bucketKey string, usage types.RateLimitUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RateLimitUsageKeyPrefix)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set([]byte(bucketKey), bz)
+}
+
+// checkAndRecordRateLimit enforces the rate limit configured for the given
+// tier, if any, against a deposit of amount. On success, amount is
+// recorded against the bucket's rolling window. On overflow, it returns
+// ErrRateLimited without recording anything and emits RateLimitTriggered.
+func (k Keeper) checkAndRecordRateLimit(
+	ctx sdk.Context,
+	perAccount bool,
+	denom string,
+	destinationDomain uint32,
+	account string,
+	amount math.Int,
+) error {
+	limit, found := k.GetRateLimit(ctx, perAccount, denom, destinationDomain)
+	if !found {
+		return nil
+	}
+
+	bucketKey := types.RateLimitBucketKey(perAccount, denom, destinationDomain, account)
+	height := ctx.BlockHeight()
+
+	trimmed, used := k.GetRateLimitUsage(ctx, bucketKey).Trim(height, limit.WindowBlocks)
+	if used.Add(amount).GT(limit.Amount) {
+		retryAtHeight := height + int64(limit.WindowBlocks)
+		if len(trimmed.Entries) > 0 {
+			retryAtHeight = trimmed.Entries[0].BlockHeight + int64(limit.WindowBlocks)
+		}
+
+		event := types.RateLimitTriggered{
+			BucketKey:     bucketKey,
+			RetryAtHeight: retryAtHeight,
+		}
+		_ = ctx.EventManager().EmitTypedEvent(&event)
+
+		return errors.Wrapf(types.ErrRateLimited, "bucket %s is rate limited, retry at height %d", bucketKey, retryAtHeight)
+	}
+
+	trimmed.Entries = append(trimmed.Entries, types.RateLimitUsageEntry{BlockHeight: height, Amount: amount})
+	k.setRateLimitUsage(ctx, bucketKey, trimmed)
+	return nil
+}
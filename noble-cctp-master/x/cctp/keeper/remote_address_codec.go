@@ -0,0 +1,75 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+var RemoteAddressCodecKeyPrefix = []byte("remote-address-codec-value-")
+
+// SetRemoteAddressCodecConfig registers the address codec used for a single
+// remote domain.
+func (k Keeper) SetRemoteAddressCodecConfig(ctx sdk.Context, config types.RemoteAddressCodecConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RemoteAddressCodecKeyPrefix)
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, config.RemoteDomain)
+	bz := k.cdc.MustMarshal(&config)
+	store.Set(domainBz, bz)
+}
+
+// GetRemoteAddressCodecConfig returns the address codec configuration
+// registered for remoteDomain, if any.
+func (k Keeper) GetRemoteAddressCodecConfig(ctx sdk.Context, remoteDomain uint32) (types.RemoteAddressCodecConfig, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RemoteAddressCodecKeyPrefix)
+	domainBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(domainBz, remoteDomain)
+
+	bz := store.Get(domainBz)
+	if bz == nil {
+		return types.RemoteAddressCodecConfig{}, false
+	}
+
+	var config types.RemoteAddressCodecConfig
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// GetRemoteAddressCodec resolves the RemoteAddressCodec registered for
+// remoteDomain. Domains without a registered config default to the EVM
+// codec, preserving the original right-padded 20-byte address behavior.
+func (k Keeper) GetRemoteAddressCodec(ctx sdk.Context, remoteDomain uint32) (types.RemoteAddressCodec, error) {
+	config, found := k.GetRemoteAddressCodecConfig(ctx, remoteDomain)
+	if !found {
+		return types.NewEVMAddressCodec(), nil
+	}
+
+	switch config.CodecType {
+	case types.CodecTypeEVM, "":
+		return types.NewEVMAddressCodec(), nil
+	case types.CodecTypeCosmos:
+		return types.NewCosmosAddressCodec(config.Bech32Prefix), nil
+	default:
+		return nil, errors.Wrapf(types.ErrUnsupportedCodec, "codec type: %s is not supported", config.CodecType)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// CodecTypeEVM is the default codec, matching the original right-padded
+	// 20-byte EVM address behavior.
+	CodecTypeEVM = "evm"
+	// CodecTypeCosmos decodes/encodes bech32 account addresses.
+	CodecTypeCosmos = "cosmos"
+)
+
+// RemoteAddressCodec validates and normalizes the 32-byte remote-format
+// addresses (mint recipients, remote tokens, destination callers) used by a
+// single remote domain, and derives the remote identifier for a given
+// human-readable token address.
+type RemoteAddressCodec interface {
+	// StringToBytes converts a domain-native address string into its 32-byte
+	// remote format.
+	StringToBytes(address string) ([]byte, error)
+	// BytesToString converts a 32-byte remote format address back into its
+	// domain-native string representation.
+	BytesToString(bz []byte) (string, error)
+	// Validate returns an error if bz is not a well-formed 32-byte remote
+	// format address for this domain.
+	Validate(bz []byte) error
+	// TokenIdentifier derives the remote token identifier used in burn
+	// messages and token pair lookups from a domain-native token address.
+	TokenIdentifier(token string) ([]byte, error)
+	// ValidateTokenIdentifier returns an error if bz is not a well-formed
+	// 32-byte remote token identifier. Unlike Validate, this does not
+	// enforce address padding, since a token identifier (e.g. the keccak256
+	// hash CCTP uses for EVM tokens) is not an address.
+	ValidateTokenIdentifier(bz []byte) error
+}
+
+// EVMAddressCodec handles right-padded 20-byte EVM addresses, hex-encoded
+// with a 0x prefix.
+type EVMAddressCodec struct{}
+
+func NewEVMAddressCodec() EVMAddressCodec {
+	return EVMAddressCodec{}
+}
+
+func (EVMAddressCodec) StringToBytes(address string) ([]byte, error) {
+	address = strings.TrimPrefix(address, "0x")
+	raw, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > 20 {
+		return nil, fmt.Errorf("evm address exceeds 20 bytes")
+	}
+
+	bz := make([]byte, MintRecipientLen)
+	copy(bz[32-len(raw):], raw)
+	return bz, nil
+}
+
+func (EVMAddressCodec) BytesToString(bz []byte) (string, error) {
+	if len(bz) != MintRecipientLen {
+		return "", fmt.Errorf("address must be a %d byte array", MintRecipientLen)
+	}
+	return "0x" + hex.EncodeToString(bz[12:]), nil
+}
+
+func (EVMAddressCodec) Validate(bz []byte) error {
+	if len(bz) != MintRecipientLen {
+		return fmt.Errorf("address must be a %d byte array", MintRecipientLen)
+	}
+	if bytes.Equal(bz, make([]byte, MintRecipientLen)) {
+		return fmt.Errorf("address must be nonzero")
+	}
+	if !bytes.Equal(bz[:12], make([]byte, 12)) {
+		return fmt.Errorf("evm address must be right-padded to 20 bytes")
+	}
+	return nil
+}
+
+func (EVMAddressCodec) TokenIdentifier(token string) ([]byte, error) {
+	return crypto.Keccak256([]byte(strings.ToLower(token))), nil
+}
+
+func (EVMAddressCodec) ValidateTokenIdentifier(bz []byte) error {
+	if len(bz) != MintRecipientLen {
+		return fmt.Errorf("token identifier must be a %d byte array", MintRecipientLen)
+	}
+	if bytes.Equal(bz, make([]byte, MintRecipientLen)) {
+		return fmt.Errorf("token identifier must be nonzero")
+	}
+	return nil
+}
+
+// CosmosAddressCodec handles bech32 account addresses, left-padded into the
+// 32-byte remote format.
+type CosmosAddressCodec struct {
+	Bech32Prefix string
+}
+
+func NewCosmosAddressCodec(bech32Prefix string) CosmosAddressCodec {
+	return CosmosAddressCodec{Bech32Prefix: bech32Prefix}
+}
+
+func (c CosmosAddressCodec) StringToBytes(address string) ([]byte, error) {
+	_, raw, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > MintRecipientLen {
+		return nil, fmt.Errorf("cosmos address exceeds %d bytes", MintRecipientLen)
+	}
+
+	bz := make([]byte, MintRecipientLen)
+	copy(bz[MintRecipientLen-len(raw):], raw)
+	return bz, nil
+}
+
+func (c CosmosAddressCodec) BytesToString(bz []byte) (string, error) {
+	if len(bz) != MintRecipientLen {
+		return "", fmt.Errorf("address must be a %d byte array", MintRecipientLen)
+	}
+
+	return bech32.ConvertAndEncode(c.Bech32Prefix, bz[12:])
+}
+
+func (CosmosAddressCodec) Validate(bz []byte) error {
+	if len(bz) != MintRecipientLen {
+		return fmt.Errorf("address must be a %d byte array", MintRecipientLen)
+	}
+	if bytes.Equal(bz, make([]byte, MintRecipientLen)) {
+		return fmt.Errorf("address must be nonzero")
+	}
+	return nil
+}
+
+func (CosmosAddressCodec) TokenIdentifier(token string) ([]byte, error) {
+	return crypto.Keccak256([]byte(strings.ToLower(token))), nil
+}
+
+func (CosmosAddressCodec) ValidateTokenIdentifier(bz []byte) error {
+	if len(bz) != MintRecipientLen {
+		return fmt.Errorf("token identifier must be a %d byte array", MintRecipientLen)
+	}
+	if bytes.Equal(bz, make([]byte, MintRecipientLen)) {
+		return fmt.Errorf("token identifier must be nonzero")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func TestEVMAddressCodecRoundTrip(t *testing.T) {
+	codec := types.NewEVMAddressCodec()
+
+	bz, err := codec.StringToBytes("0x1234567890123456789012345678901234567890")
+	require.NoError(t, err)
+	require.NoError(t, codec.Validate(bz))
+
+	address, err := codec.BytesToString(bz)
+	require.NoError(t, err)
+	require.Equal(t, "0x1234567890123456789012345678901234567890", address)
+}
+
+func TestEVMAddressCodecRejectsUnpadded(t *testing.T) {
+	codec := types.NewEVMAddressCodec()
+	bz := make([]byte, types.MintRecipientLen)
+	bz[0] = 1 // set a byte in what must be the zero-padded prefix
+
+	require.Error(t, codec.Validate(bz))
+}
+
+func TestCosmosAddressCodecRoundTrip(t *testing.T) {
+	codec := types.NewCosmosAddressCodec("noble")
+
+	bz, err := codec.StringToBytes("noble1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnt4yjfh")
+	require.NoError(t, err)
+	require.NoError(t, codec.Validate(bz))
+
+	address, err := codec.BytesToString(bz)
+	require.NoError(t, err)
+	require.Equal(t, "noble1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnt4yjfh", address)
+}
+
+func TestAddressCodecsRejectZeroAddress(t *testing.T) {
+	zero := make([]byte, types.MintRecipientLen)
+	require.Error(t, types.NewEVMAddressCodec().Validate(zero))
+	require.Error(t, types.NewCosmosAddressCodec("noble").Validate(zero))
+}
+
+func TestTokenIdentifierIsDeterministicAndCaseInsensitive(t *testing.T) {
+	codec := types.NewEVMAddressCodec()
+
+	lower, err := codec.TokenIdentifier("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	require.NoError(t, err)
+
+	upper, err := codec.TokenIdentifier("0xA0B86991C6218B36C1D19D4A2E9EB0CE3606EB48")
+	require.NoError(t, err)
+
+	require.Equal(t, lower, upper)
+	require.NoError(t, codec.ValidateTokenIdentifier(lower))
+}
+
+func TestValidateTokenIdentifierRejectsWrongLengthAndZero(t *testing.T) {
+	codec := types.NewCosmosAddressCodec("noble")
+
+	require.Error(t, codec.ValidateTokenIdentifier(make([]byte, 31)))
+	require.Error(t, codec.ValidateTokenIdentifier(make([]byte, types.MintRecipientLen)))
+
+	id, err := codec.TokenIdentifier("uusdc")
+	require.NoError(t, err)
+	require.NoError(t, codec.ValidateTokenIdentifier(id))
+}
+
+func TestValidateTokenIdentifierAcceptsEVMStyleHash(t *testing.T) {
+	// Unlike Validate, a token identifier is not right-padded, so an EVM
+	// hash using the full 32 bytes must still be accepted.
+	codec := types.NewEVMAddressCodec()
+	id, err := codec.TokenIdentifier("uusdc")
+	require.NoError(t, err)
+
+	require.Error(t, codec.Validate(id))
+	require.NoError(t, codec.ValidateTokenIdentifier(id))
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// BlockedAddress is a bech32 depositor address that has been denied access
+// to DepositForBurn.
+type BlockedAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+// BlockedMintRecipient is a 32-byte remote-format mint recipient that has
+// been denied access to DepositForBurn, scoped to a single remote domain.
+type BlockedMintRecipient struct {
+	RemoteDomain  uint32 `protobuf:"varint,1,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	MintRecipient []byte `protobuf:"bytes,2,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+}
+
+type MsgAddToBlocklist struct {
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	RemoteDomain  uint32 `protobuf:"varint,3,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	MintRecipient []byte `protobuf:"bytes,4,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+}
+
+type MsgAddToBlocklistResponse struct{}
+
+type MsgRemoveFromBlocklist struct {
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	RemoteDomain  uint32 `protobuf:"varint,3,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	MintRecipient []byte `protobuf:"bytes,4,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+}
+
+type MsgRemoveFromBlocklistResponse struct{}
+
+type QueryBlockedAddressesRequest struct {
+	ByRemoteDomain bool   `protobuf:"varint,1,opt,name=by_remote_domain,json=byRemoteDomain,proto3" json:"by_remote_domain,omitempty"`
+	RemoteDomain   uint32 `protobuf:"varint,2,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+}
+
+type QueryBlockedAddressesResponse struct {
+	Addresses      []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	MintRecipients [][]byte `protobuf:"bytes,2,rep,name=mint_recipients,json=mintRecipients,proto3" json:"mint_recipients,omitempty"`
+}
+
+// BlockedAddressAdded is emitted whenever the Blocklist Admin adds a
+// depositor or mint recipient to the blocklist.
+type BlockedAddressAdded struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	RemoteDomain  uint32 `protobuf:"varint,3,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	MintRecipient []byte `protobuf:"bytes,4,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+}
+
+// BlockedAddressRemoved is emitted whenever the Blocklist Admin removes a
+// depositor or mint recipient from the blocklist.
+type BlockedAddressRemoved struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	RemoteDomain  uint32 `protobuf:"varint,3,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	MintRecipient []byte `protobuf:"bytes,4,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+}
+
+// DepositBlocked is emitted in place of DepositForBurn whenever a deposit is
+// rejected by the blocklist.
+type DepositBlocked struct {
+	Depositor         string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	MintRecipient     []byte `protobuf:"bytes,2,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Reason            string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "cosmossdk.io/math"
+
+// FiatTokenfactoryMinter is the only MinterModule supported today.
+const FiatTokenfactoryMinter = "fiattokenfactory"
+
+// BurnableToken registers a local denom as eligible for DepositForBurn,
+// along with the minter module that knows how to burn it and the remote
+// identifier used to represent it in outgoing burn messages.
+type BurnableToken struct {
+	Denom           string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinterModule    string   `protobuf:"bytes,2,opt,name=minter_module,json=minterModule,proto3" json:"minter_module,omitempty"`
+	RemoteTokenHash []byte   `protobuf:"bytes,3,opt,name=remote_token_hash,json=remoteTokenHash,proto3" json:"remote_token_hash,omitempty"`
+	Decimals        uint32   `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	PerMessageLimit math.Int `protobuf:"bytes,5,opt,name=per_message_limit,json=perMessageLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_message_limit"`
+}
+
+type MsgAddBurnableToken struct {
+	From  string        `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Token BurnableToken `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+}
+
+type MsgAddBurnableTokenResponse struct{}
+
+type MsgRemoveBurnableToken struct {
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+type MsgRemoveBurnableTokenResponse struct{}
+
+type QueryBurnableTokensRequest struct{}
+
+type QueryBurnableTokensResponse struct {
+	Tokens []BurnableToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+}
+
+type BurnableTokenAdded struct {
+	Denom        string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinterModule string `protobuf:"bytes,2,opt,name=minter_module,json=minterModule,proto3" json:"minter_module,omitempty"`
+}
+
+type BurnableTokenRemoved struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "cosmossdk.io/errors"
+
+// Error codes below 100 are reserved for the errors already registered
+// elsewhere in this module (ErrDepositForBurn, ErrBurn, ErrUnauthorized,
+// ErrInvalidAddress, etc). New features should register additional
+// sentinel errors starting at 100 to avoid collisions.
+var (
+	ErrBlocklisted            = errors.Register(ModuleName, 100, "address is blocklisted")
+	ErrInvalidFeeParams       = errors.Register(ModuleName, 101, "invalid fee params")
+	ErrTokenNotRegistered     = errors.Register(ModuleName, 102, "burnable token is not registered")
+	ErrUnsupportedMinter      = errors.Register(ModuleName, 103, "minter module is not supported")
+	ErrUnsupportedCodec       = errors.Register(ModuleName, 104, "remote address codec type is not supported")
+	ErrTokenPairAlreadyExists = errors.Register(ModuleName, 105, "token pair already exists")
+	ErrRateLimited            = errors.Register(ModuleName, 106, "rate limit exceeded")
+)
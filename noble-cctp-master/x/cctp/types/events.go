@@ -0,0 +1,36 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "cosmossdk.io/math"
+
+// DepositForBurn is emitted whenever a depositor burns tokens bound for a
+// remote domain. FeeAmount is the protocol fee taken from Amount and
+// NetAmount is what is burned; with no fee configured, FeeAmount is zero and
+// NetAmount equals Amount.
+type DepositForBurn struct {
+	Nonce                     uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BurnToken                 string   `protobuf:"bytes,2,opt,name=burn_token,json=burnToken,proto3" json:"burn_token,omitempty"`
+	Amount                    math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Depositor                 string   `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	MintRecipient             []byte   `protobuf:"bytes,5,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationDomain         uint32   `protobuf:"varint,6,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	DestinationTokenMessenger []byte   `protobuf:"bytes,7,opt,name=destination_token_messenger,json=destinationTokenMessenger,proto3" json:"destination_token_messenger,omitempty"`
+	DestinationCaller         []byte   `protobuf:"bytes,8,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	FeeAmount                 math.Int `protobuf:"bytes,9,opt,name=fee_amount,json=feeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"fee_amount"`
+	NetAmount                 math.Int `protobuf:"bytes,10,opt,name=net_amount,json=netAmount,proto3,customtype=cosmossdk.io/math.Int" json:"net_amount"`
+}
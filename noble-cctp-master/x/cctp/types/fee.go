@@ -0,0 +1,88 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "cosmossdk.io/math"
+
+// FeeParams configures the protocol-wide fee charged on DepositForBurn.
+type FeeParams struct {
+	FeeBps       uint32   `protobuf:"varint,1,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	FeeMin       math.Int `protobuf:"bytes,2,opt,name=fee_min,json=feeMin,proto3,customtype=cosmossdk.io/math.Int" json:"fee_min"`
+	FeeMax       math.Int `protobuf:"bytes,3,opt,name=fee_max,json=feeMax,proto3,customtype=cosmossdk.io/math.Int" json:"fee_max"`
+	FeeRecipient string   `protobuf:"bytes,4,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+// DomainFee overrides FeeParams for a single destination domain.
+type DomainFee struct {
+	DestinationDomain uint32   `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	FeeBps            uint32   `protobuf:"varint,2,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	FeeMin            math.Int `protobuf:"bytes,3,opt,name=fee_min,json=feeMin,proto3,customtype=cosmossdk.io/math.Int" json:"fee_min"`
+	FeeMax            math.Int `protobuf:"bytes,4,opt,name=fee_max,json=feeMax,proto3,customtype=cosmossdk.io/math.Int" json:"fee_max"`
+}
+
+type MsgSetFeeParams struct {
+	From         string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	FeeBps       uint32   `protobuf:"varint,2,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	FeeMin       math.Int `protobuf:"bytes,3,opt,name=fee_min,json=feeMin,proto3,customtype=cosmossdk.io/math.Int" json:"fee_min"`
+	FeeMax       math.Int `protobuf:"bytes,4,opt,name=fee_max,json=feeMax,proto3,customtype=cosmossdk.io/math.Int" json:"fee_max"`
+	FeeRecipient string   `protobuf:"bytes,5,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+}
+
+type MsgSetFeeParamsResponse struct{}
+
+type MsgSetPerDomainFee struct {
+	From              string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	DestinationDomain uint32   `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Clear             bool     `protobuf:"varint,3,opt,name=clear,proto3" json:"clear,omitempty"`
+	FeeBps            uint32   `protobuf:"varint,4,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	FeeMin            math.Int `protobuf:"bytes,5,opt,name=fee_min,json=feeMin,proto3,customtype=cosmossdk.io/math.Int" json:"fee_min"`
+	FeeMax            math.Int `protobuf:"bytes,6,opt,name=fee_max,json=feeMax,proto3,customtype=cosmossdk.io/math.Int" json:"fee_max"`
+}
+
+type MsgSetPerDomainFeeResponse struct{}
+
+type QueryEstimateDepositFeeRequest struct {
+	DestinationDomain uint32   `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Amount            math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BurnToken         string   `protobuf:"bytes,3,opt,name=burn_token,json=burnToken,proto3" json:"burn_token,omitempty"`
+}
+
+type QueryEstimateDepositFeeResponse struct {
+	FeeAmount math.Int `protobuf:"bytes,1,opt,name=fee_amount,json=feeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"fee_amount"`
+	NetAmount math.Int `protobuf:"bytes,2,opt,name=net_amount,json=netAmount,proto3,customtype=cosmossdk.io/math.Int" json:"net_amount"`
+}
+
+// ComputeFee applies the given fee params to amount, returning the fee and
+// net amounts. The fee is fee_bps of amount (rounded down), raised to fee_min
+// and then lowered to fee_max; a nil or zero fee_max sets no upper bound. The
+// fee never exceeds amount. Unset (nil) FeeParams, i.e. a zero FeeBps with no
+// FeeMin, charges no fee.
+func (p FeeParams) ComputeFee(amount math.Int) (fee math.Int, net math.Int) {
+	fee = amount.MulRaw(int64(p.FeeBps)).QuoRaw(10_000)
+
+	if !p.FeeMin.IsNil() && fee.LT(p.FeeMin) {
+		fee = p.FeeMin
+	}
+	if !p.FeeMax.IsNil() && !p.FeeMax.IsZero() && fee.GT(p.FeeMax) {
+		fee = p.FeeMax
+	}
+	if fee.GT(amount) {
+		fee = amount
+	}
+
+	return fee, amount.Sub(fee)
+}
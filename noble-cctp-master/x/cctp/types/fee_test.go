@@ -0,0 +1,67 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func TestComputeFeeZeroFeeBps(t *testing.T) {
+	params := types.FeeParams{}
+	fee, net := params.ComputeFee(math.NewInt(1_000_000))
+	require.True(t, fee.IsZero())
+	require.Equal(t, math.NewInt(1_000_000), net)
+}
+
+func TestComputeFeeRounding(t *testing.T) {
+	// 10 bps of 999 = 0.999, truncated to 0 by integer division.
+	params := types.FeeParams{FeeBps: 10}
+	fee, net := params.ComputeFee(math.NewInt(999))
+	require.True(t, fee.IsZero())
+	require.Equal(t, math.NewInt(999), net)
+
+	// 10 bps of 1_000_000 = 1_000.
+	fee, net = params.ComputeFee(math.NewInt(1_000_000))
+	require.Equal(t, math.NewInt(1_000), fee)
+	require.Equal(t, math.NewInt(999_000), net)
+}
+
+func TestComputeFeeMinClamp(t *testing.T) {
+	params := types.FeeParams{FeeBps: 1, FeeMin: math.NewInt(500)}
+	fee, net := params.ComputeFee(math.NewInt(1_000))
+	require.Equal(t, math.NewInt(500), fee)
+	require.Equal(t, math.NewInt(500), net)
+}
+
+func TestComputeFeeMaxClamp(t *testing.T) {
+	params := types.FeeParams{FeeBps: 100, FeeMax: math.NewInt(10)}
+	fee, net := params.ComputeFee(math.NewInt(1_000_000))
+	require.Equal(t, math.NewInt(10), fee)
+	require.Equal(t, math.NewInt(999_990), net)
+}
+
+func TestComputeFeeCannotExceedAmount(t *testing.T) {
+	params := types.FeeParams{FeeBps: 1, FeeMin: math.NewInt(1_000)}
+	fee, net := params.ComputeFee(math.NewInt(100))
+	require.Equal(t, math.NewInt(100), fee)
+	require.True(t, net.IsZero())
+}
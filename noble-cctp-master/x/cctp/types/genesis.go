@@ -0,0 +1,30 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// GenesisState defines the cctp module's genesis state.
+type GenesisState struct {
+	BlocklistAdmin        string                 `protobuf:"bytes,1,opt,name=blocklist_admin,json=blocklistAdmin,proto3" json:"blocklist_admin,omitempty"`
+	BlockedAddresses      []BlockedAddress       `protobuf:"bytes,2,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses"`
+	BlockedMintRecipients []BlockedMintRecipient `protobuf:"bytes,3,rep,name=blocked_mint_recipients,json=blockedMintRecipients,proto3" json:"blocked_mint_recipients"`
+	BurnableTokens        []BurnableToken        `protobuf:"bytes,4,rep,name=burnable_tokens,json=burnableTokens,proto3" json:"burnable_tokens"`
+}
+
+// DefaultGenesis returns the default genesis state for the cctp module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// RateLimit caps the total amount that can move through DepositForBurn
+// within a rolling window of WindowBlocks blocks. When PerAccount is
+// false, the cap applies to the aggregate of all deposits for (Denom,
+// DestinationDomain). When true, it applies per depositor `from` account
+// instead, and Denom/DestinationDomain are ignored.
+type RateLimit struct {
+	PerAccount        bool     `protobuf:"varint,1,opt,name=per_account,json=perAccount,proto3" json:"per_account,omitempty"`
+	Denom             string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	DestinationDomain uint32   `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	WindowBlocks      uint64   `protobuf:"varint,4,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	Amount            math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+// RateLimitUsageEntry records a single deposit counted against a rate
+// limit's rolling window.
+type RateLimitUsageEntry struct {
+	BlockHeight int64    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Amount      math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+// RateLimitUsage is the rolling window of deposits counted against a
+// single rate limit bucket.
+type RateLimitUsage struct {
+	Entries []RateLimitUsageEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+type MsgSetRateLimit struct {
+	From  string    `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Limit RateLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+type MsgSetRateLimitResponse struct{}
+
+type MsgRemoveRateLimit struct {
+	From              string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	PerAccount        bool   `protobuf:"varint,2,opt,name=per_account,json=perAccount,proto3" json:"per_account,omitempty"`
+	Denom             string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,4,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Account           string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+type MsgRemoveRateLimitResponse struct{}
+
+type QueryRateLimitUsageRequest struct {
+	PerAccount        bool   `protobuf:"varint,1,opt,name=per_account,json=perAccount,proto3" json:"per_account,omitempty"`
+	Denom             string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Account           string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+type QueryRateLimitUsageResponse struct {
+	Used         math.Int `protobuf:"bytes,1,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	Remaining    math.Int `protobuf:"bytes,2,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+	WindowBlocks uint64   `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	Amount       math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+type RateLimitTriggered struct {
+	BucketKey     string `protobuf:"bytes,1,opt,name=bucket_key,json=bucketKey,proto3" json:"bucket_key,omitempty"`
+	RetryAtHeight int64  `protobuf:"varint,2,opt,name=retry_at_height,json=retryAtHeight,proto3" json:"retry_at_height,omitempty"`
+}
+
+// RateLimitConfigKey identifies which RateLimit configuration applies: the
+// single global per-account configuration, or the aggregate configuration
+// for a (denom, destinationDomain) pair.
+func RateLimitConfigKey(perAccount bool, denom string, destinationDomain uint32) string {
+	if perAccount {
+		return "account"
+	}
+	return fmt.Sprintf("domain/%d/%s", destinationDomain, strings.ToLower(denom))
+}
+
+// RateLimitBucketKey identifies the rolling-window usage bucket a deposit
+// is counted against: the depositor's own bucket under a per-account
+// configuration, or the bucket shared by every deposit for (denom,
+// destinationDomain) under an aggregate configuration.
+func RateLimitBucketKey(perAccount bool, denom string, destinationDomain uint32, account string) string {
+	if perAccount {
+		return fmt.Sprintf("account/%s", account)
+	}
+	return RateLimitConfigKey(false, denom, destinationDomain)
+}
+
+// Trim returns the subset of usage entries still within windowBlocks of
+// currentHeight, along with their summed amount. Entries are kept in
+// their original (ascending block height) order.
+func (u RateLimitUsage) Trim(currentHeight int64, windowBlocks uint64) (RateLimitUsage, math.Int) {
+	sum := math.ZeroInt()
+	trimmed := RateLimitUsage{}
+	for _, entry := range u.Entries {
+		if currentHeight-entry.BlockHeight >= int64(windowBlocks) {
+			continue
+		}
+		trimmed.Entries = append(trimmed.Entries, entry)
+		sum = sum.Add(entry.Amount)
+	}
+	return trimmed, sum
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+func TestRateLimitUsageTrimDropsStaleEntries(t *testing.T) {
+	usage := types.RateLimitUsage{
+		Entries: []types.RateLimitUsageEntry{
+			{BlockHeight: 1, Amount: math.NewInt(100)},
+			{BlockHeight: 50, Amount: math.NewInt(200)},
+		},
+	}
+
+	trimmed, used := usage.Trim(100, 10)
+	require.Len(t, trimmed.Entries, 0)
+	require.True(t, used.IsZero())
+}
+
+func TestRateLimitUsageTrimKeepsEntriesWithinWindow(t *testing.T) {
+	usage := types.RateLimitUsage{
+		Entries: []types.RateLimitUsageEntry{
+			{BlockHeight: 1, Amount: math.NewInt(100)},
+			{BlockHeight: 95, Amount: math.NewInt(200)},
+		},
+	}
+
+	trimmed, used := usage.Trim(100, 10)
+	require.Len(t, trimmed.Entries, 1)
+	require.Equal(t, math.NewInt(200), used)
+}
+
+func TestRateLimitConfigKeyPerAccountIgnoresDenomAndDomain(t *testing.T) {
+	require.Equal(t, types.RateLimitConfigKey(true, "uusdc", 4), types.RateLimitConfigKey(true, "other", 9))
+}
+
+func TestRateLimitConfigKeyAggregateVariesByDenomAndDomain(t *testing.T) {
+	require.NotEqual(t, types.RateLimitConfigKey(false, "uusdc", 4), types.RateLimitConfigKey(false, "uusdc", 9))
+	require.NotEqual(t, types.RateLimitConfigKey(false, "uusdc", 4), types.RateLimitConfigKey(false, "other", 4))
+}
+
+func TestRateLimitBucketKeyPerAccountVariesByAccount(t *testing.T) {
+	require.NotEqual(t,
+		types.RateLimitBucketKey(true, "uusdc", 4, "addr1"),
+		types.RateLimitBucketKey(true, "uusdc", 4, "addr2"),
+	)
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// RemoteAddressCodecConfig selects the address codec used to validate and
+// normalize mint recipients, remote tokens, and destination callers for a
+// single remote domain.
+type RemoteAddressCodecConfig struct {
+	RemoteDomain uint32 `protobuf:"varint,1,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	CodecType    string `protobuf:"bytes,2,opt,name=codec_type,json=codecType,proto3" json:"codec_type,omitempty"`
+	Bech32Prefix string `protobuf:"bytes,3,opt,name=bech32_prefix,json=bech32Prefix,proto3" json:"bech32_prefix,omitempty"`
+}
+
+type MsgRegisterRemoteAddressCodec struct {
+	From   string                   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Config RemoteAddressCodecConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+type MsgRegisterRemoteAddressCodecResponse struct{}
+
+type RemoteAddressCodecRegistered struct {
+	RemoteDomain uint32 `protobuf:"varint,1,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	CodecType    string `protobuf:"bytes,2,opt,name=codec_type,json=codecType,proto3" json:"codec_type,omitempty"`
+}
+
+type MsgLinkTokenPair struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	LocalToken   string `protobuf:"bytes,2,opt,name=local_token,json=localToken,proto3" json:"local_token,omitempty"`
+	RemoteDomain uint32 `protobuf:"varint,3,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	RemoteToken  []byte `protobuf:"bytes,4,opt,name=remote_token,json=remoteToken,proto3" json:"remote_token,omitempty"`
+}
+
+type MsgLinkTokenPairResponse struct{}
+
+type TokenPairLinked struct {
+	LocalToken   string `protobuf:"bytes,1,opt,name=local_token,json=localToken,proto3" json:"local_token,omitempty"`
+	RemoteDomain uint32 `protobuf:"varint,2,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	RemoteToken  []byte `protobuf:"bytes,3,opt,name=remote_token,json=remoteToken,proto3" json:"remote_token,omitempty"`
+}